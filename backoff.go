@@ -0,0 +1,86 @@
+package reconnect
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Backoff computes how long to wait before the next reconnect attempt,
+// given the number of consecutive failures since the last success.
+type Backoff func(attempt int) time.Duration
+
+// ConstantBackoff returns a Backoff that always waits d between attempts.
+func ConstantBackoff(d time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		return d
+	}
+}
+
+// LinearBackoff returns a Backoff that waits attempt*d between attempts,
+// never exceeding max.
+func LinearBackoff(d, max time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		wait := time.Duration(attempt) * d
+		if wait > max {
+			return max
+		}
+		return wait
+	}
+}
+
+// ExponentialBackoff returns a Backoff that doubles base on every attempt,
+// never exceeding max.
+func ExponentialBackoff(base, max time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		if attempt < 1 {
+			attempt = 1
+		}
+		wait := base << uint(attempt-1)
+		if wait <= 0 || wait > max {
+			return max
+		}
+		return wait
+	}
+}
+
+// ExponentialBackoffWithFullJitter is like ExponentialBackoff but returns a
+// random duration between 0 and the computed delay, so that multiple clients
+// backing off at the same time don't retry in lockstep.
+func ExponentialBackoffWithFullJitter(base, max time.Duration) Backoff {
+	exp := ExponentialBackoff(base, max)
+	return func(attempt int) time.Duration {
+		d := exp(attempt)
+		if d <= 0 {
+			return 0
+		}
+		return time.Duration(rand.Int63n(int64(d)))
+	}
+}
+
+// waitOutcome reports why wait returned.
+type waitOutcome int
+
+const (
+	// waitElapsed means the full delay was waited out.
+	waitElapsed waitOutcome = iota
+	// waitClosed means the connection was closed before the delay elapsed.
+	waitClosed
+	// waitForceReconnect means Reconnect() interrupted the delay.
+	waitForceReconnect
+)
+
+// wait sleeps for d, returning early if the connection is closed or a
+// reconnect is forced before the delay elapses.
+func (c *reconnect) wait(d time.Duration) waitOutcome {
+	if d <= 0 {
+		return waitElapsed
+	}
+	select {
+	case <-time.After(d):
+		return waitElapsed
+	case <-c.closing:
+		return waitClosed
+	case <-c.forceReconnect:
+		return waitForceReconnect
+	}
+}
@@ -1,5 +1,11 @@
 package reconnect
 
+import (
+	"context"
+	"sync"
+	"time"
+)
+
 type connection interface {
 	// Connect will stablish a connection
 	Connect() error
@@ -15,23 +21,40 @@ type connection interface {
 type Reconnect interface {
 	// Start inits the reconnect process and blocks until closed or failed
 	Start() error
+	// StartContext is like Start, but also returns once ctx is done, letting
+	// callers cancel the reconnect loop without racing on Close(). ctx is
+	// checked before each connect attempt and while Wait() blocks; a Backoff
+	// pause that is already under way is not cut short by it.
+	StartContext(ctx context.Context) error
+	// Reconnect asks a currently connected instance to drop the underlying
+	// connection and go through the full reconnect cycle, without tearing
+	// down Start's loop. Useful for credential rotation, server migration or
+	// forcing failover.
+	Reconnect() error
 	// Close closes the underlying connection
 	Close() error
 }
 
 type reconnect struct {
-	conn    connection
-	opts    Options
-	closing chan struct{}
-	closed  chan struct{}
+	conn           connection
+	opts           Options
+	closing        chan struct{}
+	closed         chan struct{}
+	forceReconnect chan struct{}
+
+	connectMu   sync.Mutex
+	connectWait chan error
 }
 
 func (c *reconnect) Start() error {
+	return c.StartContext(context.Background())
+}
+
+func (c *reconnect) StartContext(ctx context.Context) error {
 	var (
 		connectAttempts  int
 		connectionErrors int
 		opts             = c.opts
-		stopErr          error
 	)
 	defer func() {
 		close(c.closed)
@@ -42,41 +65,74 @@ func (c *reconnect) Start() error {
 		case <-c.closing:
 			notifyState(opts.NotifyState, StateClosed)
 			return nil
+		case <-ctx.Done():
+			notifyState(opts.NotifyState, StateClosed)
+			return ctx.Err()
+		case <-c.forceReconnect:
+			connectAttempts = 0
+			connectionErrors = 0
 		default:
 		}
 		var err error
-		if err = c.conn.Connect(); err != nil {
-			stopErr = notifyError(opts.NotifyError, err)
+		if err = c.connect(ctx, opts); err != nil {
+			notifiedErr := notifyError(opts.NotifyError, err)
 			notifyState(opts.NotifyState, StateFailing)
+			if IsFatal(notifiedErr) {
+				notifyState(opts.NotifyState, StateFailed)
+				return notifiedErr
+			}
 			connectAttempts++
 		} else {
 			notifyState(opts.NotifyState, StateConnected)
 			connectAttempts = 0
 		}
-		if stopErr != nil {
-			notifyState(opts.NotifyState, StateFailed)
-			return stopErr
-		}
 		if opts.MaxConnectAttempts > 0 && connectAttempts == opts.MaxConnectAttempts {
 			notifyState(opts.NotifyState, StateFailed)
 			return err
 		}
 		if err != nil {
 			notifyState(opts.NotifyState, StateReconnecting)
+			if opts.Backoff != nil {
+				switch c.wait(opts.Backoff(connectAttempts)) {
+				case waitClosed:
+					notifyState(opts.NotifyState, StateClosed)
+					return nil
+				case waitForceReconnect:
+					connectAttempts = 0
+					connectionErrors = 0
+				}
+			}
 			continue
 		}
-		if err = c.conn.Wait(); err != nil {
-			stopErr = notifyError(opts.NotifyError, err)
+		healthCheckStop := make(chan struct{})
+		go c.runHealthCheck(opts, healthCheckStop)
+		ctxWatchStop := make(chan struct{})
+		go c.watchContext(ctx, ctxWatchStop)
+		err = c.conn.Wait()
+		close(healthCheckStop)
+		close(ctxWatchStop)
+		forced := false
+		select {
+		case <-c.forceReconnect:
+			forced = true
+		default:
+		}
+		if err != nil {
+			notifiedErr := notifyError(opts.NotifyError, err)
 			notifyState(opts.NotifyState, StateFailing)
-			connectionErrors++
+			if IsFatal(notifiedErr) {
+				notifyState(opts.NotifyState, StateFailed)
+				return notifiedErr
+			}
+			if forced {
+				connectionErrors = 0
+			} else {
+				connectionErrors++
+			}
 		} else {
 			notifyState(opts.NotifyState, StateDisconnected)
 			connectionErrors = 0
 		}
-		if stopErr != nil {
-			notifyState(opts.NotifyState, StateFailed)
-			return stopErr
-		}
 		if opts.MaxConnectionErrors > 0 && connectionErrors == opts.MaxConnectionErrors {
 			notifyState(opts.NotifyState, StateFailed)
 			return err
@@ -85,6 +141,16 @@ func (c *reconnect) Start() error {
 		case <-c.closing:
 		default:
 			notifyState(opts.NotifyState, StateReconnecting)
+			if err != nil && !forced && opts.Backoff != nil {
+				switch c.wait(opts.Backoff(connectionErrors)) {
+				case waitClosed:
+					notifyState(opts.NotifyState, StateClosed)
+					return nil
+				case waitForceReconnect:
+					connectAttempts = 0
+					connectionErrors = 0
+				}
+			}
 		}
 	}
 }
@@ -102,6 +168,14 @@ func notifyState(fn func(ConnState), state ConnState) {
 	}
 }
 
+func (c *reconnect) Reconnect() error {
+	select {
+	case c.forceReconnect <- struct{}{}:
+	default:
+	}
+	return c.conn.Close()
+}
+
 func (c *reconnect) Close() error {
 	close(c.closing)
 	err := c.conn.Close()
@@ -160,10 +234,34 @@ type Options struct {
 	MaxConnectAttempts int
 	// Max amount of errors returned by Wait()
 	MaxConnectionErrors int
-	// Optional handler to log errors from Connect() and Wait()
+	// Optional handler to log errors from Connect() and Wait(). A plain
+	// returned error (or nil) is ignored: the original error feeds the normal
+	// retry machinery (counters, backoff) and is what Start returns once a
+	// limit is reached. Wrap it with Fatal to stop retrying immediately instead.
 	NotifyError func(error) error
 	// Optional handler to log state changes. It can be used to block reconnection
 	NotifyState func(ConnState)
+	// Optional backoff strategy invoked before retrying after a failed
+	// Connect() or an error from Wait(), with the number of consecutive
+	// failures since the last success. It is not invoked after Wait()
+	// returns nil or after a Reconnect() call. When nil,
+	// Start retries immediately, as before.
+	Backoff Backoff
+	// Optional probe called every HealthCheckInterval while Wait() is
+	// blocked. If it returns an error, the underlying connection is closed
+	// so Wait() unblocks and the normal reconnect path runs. Has no effect
+	// unless HealthCheckInterval is also set.
+	HealthCheck func(context.Context) error
+	// How often to call HealthCheck. HealthCheck is disabled when this is 0.
+	HealthCheckInterval time.Duration
+	// Optional timeout for each Connect() call. When >0 and exceeded, the
+	// attempt is treated as a connect error feeding into MaxConnectAttempts
+	// and NotifyError, same as any other error from Connect(). If conn
+	// doesn't implement connectionContext, a Connect() call that never
+	// returns keeps a single goroutine blocked on it across retries, rather
+	// than leaking one per attempt; implement connectionContext to let it be
+	// canceled instead.
+	ConnectTimeout time.Duration
 }
 
 // New initializes a reconnection struct
@@ -175,6 +273,7 @@ func New(c connection, params ...func(*Options)) Reconnect {
 	r := reconnect{
 		conn: c, opts: opts,
 		closing: make(chan struct{}), closed: make(chan struct{}),
+		forceReconnect: make(chan struct{}, 1),
 	}
 	return &r
 }
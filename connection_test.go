@@ -1,6 +1,7 @@
 package reconnect
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"testing"
@@ -118,6 +119,39 @@ func TestNotifyErrorsNotifiesConnectionErrors(t *testing.T) {
 	assert.Equal(t, calls, 1)
 }
 
+func TestNonFatalNotifyErrorKeepsRetryingUntilMaxConnectAttempts(t *testing.T) {
+	c := &mockConnection{}
+	err := errors.New("fail")
+	calls := 0
+	c.On("Connect").Return(err).Times(3)
+	r := New(c, func(o *Options) {
+		o.NotifyError = func(err error) error {
+			calls++
+			return err
+		}
+		o.MaxConnectAttempts = 3
+	})
+	assert.Error(t, r.Start())
+	assert.Equal(t, 3, calls)
+}
+
+func TestNonFatalNotifyErrorKeepsRetryingUntilMaxConnectionErrors(t *testing.T) {
+	c := &mockConnection{}
+	err := errors.New("fail")
+	calls := 0
+	c.On("Connect").Return(nil).Times(3)
+	c.On("Wait").Return(err).Times(3)
+	r := New(c, func(o *Options) {
+		o.NotifyError = func(err error) error {
+			calls++
+			return err
+		}
+		o.MaxConnectionErrors = 3
+	})
+	assert.Error(t, r.Start())
+	assert.Equal(t, 3, calls)
+}
+
 func TestNotifyErrorsStopsRetriesWhenErrorIsReturnedOnConnection(t *testing.T) {
 	c := &mockConnection{}
 	err := errors.New("fail")
@@ -128,7 +162,7 @@ func TestNotifyErrorsStopsRetriesWhenErrorIsReturnedOnConnection(t *testing.T) {
 		calls++
 		assert.Error(t, err)
 		if calls == 3 {
-			return err
+			return Fatal(err)
 		}
 		return nil
 	}
@@ -149,7 +183,7 @@ func TestNotifyErrorsStopsRetriesWhenErrorIsReturnedConnecting(t *testing.T) {
 		calls++
 		assert.Error(t, err)
 		if calls == 3 {
-			return err
+			return Fatal(err)
 		}
 		return nil
 	}
@@ -226,6 +260,185 @@ func TestStringEvents(t *testing.T) {
 	r.Start()
 }
 
+func TestBackoffIsCalledWithAttemptCountAndCanBeInterruptedByClose(t *testing.T) {
+	c := &mockConnection{}
+	err := errors.New("fail")
+	connected := make(chan struct{})
+	c.On("Connect").Return(err).Once().Run(func(_ mock.Arguments) {
+		close(connected)
+	})
+	c.On("Close").Return(nil)
+	var attempts []int
+	backoff := func(attempt int) time.Duration {
+		attempts = append(attempts, attempt)
+		return time.Hour
+	}
+	r := New(c, func(o *Options) {
+		o.Backoff = backoff
+	})
+	go r.Start()
+	<-connected
+	assert.NoError(t, r.Close())
+	assert.Equal(t, []int{1}, attempts)
+}
+
+func TestFatalErrorStopsRetriesImmediatelyOnConnect(t *testing.T) {
+	c := &mockConnection{}
+	err := errors.New("forbidden")
+	c.On("Connect").Return(err).Once()
+	calls := 0
+	r := New(c, func(o *Options) {
+		o.NotifyError = func(err error) error {
+			calls++
+			return Fatal(err)
+		}
+		o.MaxConnectAttempts = 10
+	})
+	resultErr := r.Start()
+	assert.True(t, IsFatal(resultErr))
+	assert.Equal(t, 1, calls)
+}
+
+func TestFatalErrorStopsRetriesImmediatelyOnWait(t *testing.T) {
+	c := &mockConnection{}
+	err := errors.New("forbidden")
+	c.On("Connect").Return(nil).Once()
+	c.On("Wait").Return(err).Once()
+	r := New(c, func(o *Options) {
+		o.NotifyError = func(err error) error {
+			return Fatal(err)
+		}
+		o.MaxConnectionErrors = 10
+	})
+	resultErr := r.Start()
+	assert.True(t, IsFatal(resultErr))
+}
+
+func TestHealthCheckClosesConnectionOnFailure(t *testing.T) {
+	c := &mockConnection{}
+	closed := make(chan time.Time)
+	c.On("Connect").Return(nil).Once()
+	c.On("Wait").Return(nil).Once().WaitUntil(closed)
+	c.On("Close").Return(nil).Once().Run(func(_ mock.Arguments) {
+		close(closed)
+	})
+	c.On("Connect").Return(errors.New("fail")).Once()
+	calls := 0
+	r := New(c, func(o *Options) {
+		o.HealthCheckInterval = time.Millisecond
+		o.HealthCheck = func(ctx context.Context) error {
+			calls++
+			return errors.New("unhealthy")
+		}
+		o.MaxConnectAttempts = 1
+	})
+	r.Start()
+	c.AssertCalled(t, "Close")
+	assert.True(t, calls > 0)
+}
+
+func TestReconnectTriggersFullReconnectCycle(t *testing.T) {
+	c := &mockConnection{}
+	connected := make(chan struct{})
+	forced := make(chan time.Time)
+	c.On("Connect").Return(nil).Once().Run(func(_ mock.Arguments) {
+		close(connected)
+	})
+	c.On("Wait").Return(nil).Once().WaitUntil(forced)
+	c.On("Close").Return(nil).Once().Run(func(_ mock.Arguments) {
+		close(forced)
+	})
+	c.On("Connect").Return(errors.New("fail")).Once()
+	r := New(c, func(o *Options) {
+		o.MaxConnectAttempts = 1
+	})
+	done := make(chan error, 1)
+	go func() {
+		done <- r.Start()
+	}()
+	<-connected
+	assert.NoError(t, r.Reconnect())
+	assert.Error(t, <-done)
+	c.AssertNumberOfCalls(t, "Connect", 2)
+}
+
+func TestReconnectInterruptsBackoffPause(t *testing.T) {
+	c := &mockConnection{}
+	firstAttempt := make(chan struct{})
+	secondConnected := make(chan struct{})
+	closeCh := make(chan time.Time)
+	c.On("Connect").Return(errors.New("fail")).Once().Run(func(_ mock.Arguments) {
+		close(firstAttempt)
+	})
+	c.On("Close").Return(nil).Once()
+	c.On("Connect").Return(nil).Once().Run(func(_ mock.Arguments) {
+		close(secondConnected)
+	})
+	c.On("Wait").Return(nil).Once().WaitUntil(closeCh)
+	c.On("Close").Return(nil).Once().Run(func(_ mock.Arguments) {
+		close(closeCh)
+	})
+	r := New(c, func(o *Options) {
+		o.Backoff = ConstantBackoff(time.Hour)
+	})
+	done := make(chan error, 1)
+	go func() {
+		done <- r.Start()
+	}()
+	<-firstAttempt
+	assert.NoError(t, r.Reconnect())
+	<-secondConnected
+	assert.NoError(t, r.Close())
+	<-done
+	c.AssertNumberOfCalls(t, "Connect", 2)
+}
+
+func TestStartContextReturnsWhenContextIsDone(t *testing.T) {
+	c := &mockConnection{}
+	connected := make(chan struct{})
+	waitCh := make(chan time.Time)
+	c.On("Connect").Return(nil).Once().Run(func(_ mock.Arguments) {
+		close(connected)
+	})
+	c.On("Wait").Return(nil).Once().WaitUntil(waitCh)
+	c.On("Close").Return(nil).Once().Run(func(_ mock.Arguments) {
+		close(waitCh)
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	r := New(c)
+	done := make(chan error, 1)
+	go func() {
+		done <- r.StartContext(ctx)
+	}()
+	<-connected
+	cancel()
+	assert.Equal(t, context.Canceled, <-done)
+	c.AssertCalled(t, "Close")
+}
+
+func TestConnectTimeoutIsTreatedAsConnectError(t *testing.T) {
+	c := &mockConnection{}
+	c.On("Connect").Return(nil).WaitUntil(make(chan time.Time)).Once()
+	r := New(c, func(o *Options) {
+		o.ConnectTimeout = time.Millisecond
+		o.MaxConnectAttempts = 1
+	})
+	err := r.Start()
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestConnectTimeoutReusesPendingConnectGoroutine(t *testing.T) {
+	c := &mockConnection{}
+	c.On("Connect").Return(nil).WaitUntil(make(chan time.Time)).Once()
+	r := New(c, func(o *Options) {
+		o.ConnectTimeout = time.Millisecond
+		o.MaxConnectAttempts = 3
+	})
+	err := r.Start()
+	assert.Equal(t, context.DeadlineExceeded, err)
+	c.AssertNumberOfCalls(t, "Connect", 1)
+}
+
 type lifecycleExpectation struct {
 	result []ConnState
 }
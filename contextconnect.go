@@ -0,0 +1,74 @@
+package reconnect
+
+import (
+	"context"
+)
+
+// connectionContext is implemented by connections that support connecting
+// with a context, so Start can cancel a connect attempt instead of blocking
+// on it forever. Implementing it is optional: connections without it keep
+// working through connect's goroutine-based timeout fallback.
+type connectionContext interface {
+	// ConnectContext is like Connect but returns as soon as ctx is done.
+	ConnectContext(ctx context.Context) error
+}
+
+// connect establishes the connection, bounding it by opts.ConnectTimeout (if
+// set) and ctx. It prefers conn's ConnectContext when available; otherwise,
+// when ConnectTimeout is set, it falls back to running Connect in a goroutine
+// and racing it against ctx, since a plain Connect() can't be interrupted once
+// called. Without either, Connect() is called directly and ctx is not
+// consulted.
+func (c *reconnect) connect(ctx context.Context, opts Options) error {
+	if opts.ConnectTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.ConnectTimeout)
+		defer cancel()
+	}
+	if cc, ok := c.conn.(connectionContext); ok {
+		return cc.ConnectContext(ctx)
+	}
+	if opts.ConnectTimeout <= 0 {
+		return c.conn.Connect()
+	}
+	select {
+	case err := <-c.pendingConnect():
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// pendingConnect returns a channel receiving the result of conn.Connect().
+// If a previous timed-out attempt is still blocked in Connect(), it returns
+// that same goroutine's channel instead of starting a new one, so a
+// connection that never returns from Connect() only ever leaks a single
+// goroutine no matter how many attempts Start retries.
+func (c *reconnect) pendingConnect() <-chan error {
+	c.connectMu.Lock()
+	defer c.connectMu.Unlock()
+	if c.connectWait == nil {
+		ch := make(chan error, 1)
+		c.connectWait = ch
+		go func() {
+			err := c.conn.Connect()
+			c.connectMu.Lock()
+			c.connectWait = nil
+			c.connectMu.Unlock()
+			ch <- err
+		}()
+	}
+	return c.connectWait
+}
+
+// watchContext closes the underlying connection if ctx is done before stop
+// is closed, so a Wait() blocked on a healthy connection unblocks as soon as
+// StartContext's caller cancels ctx, instead of only on a real disconnect or
+// Close().
+func (c *reconnect) watchContext(ctx context.Context, stop <-chan struct{}) {
+	select {
+	case <-ctx.Done():
+		c.conn.Close()
+	case <-stop:
+	}
+}
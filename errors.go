@@ -0,0 +1,34 @@
+package reconnect
+
+import "errors"
+
+// fatalError marks an error as non-retryable.
+type fatalError struct {
+	err error
+}
+
+func (f *fatalError) Error() string {
+	return f.err.Error()
+}
+
+func (f *fatalError) Unwrap() error {
+	return f.err
+}
+
+// Fatal wraps err so that, when returned from a NotifyError handler, Start
+// stops the reconnect loop immediately instead of retrying: it transitions
+// to StateFailed and returns without touching the attempt counters. Use it
+// to surface errors that reconnecting can never fix, such as an auth
+// rejection from the underlying connection.
+func Fatal(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &fatalError{err: err}
+}
+
+// IsFatal reports whether err, or any error it wraps, was marked fatal by Fatal.
+func IsFatal(err error) bool {
+	var f *fatalError
+	return errors.As(err, &f)
+}
@@ -0,0 +1,32 @@
+package reconnect
+
+import (
+	"context"
+	"time"
+)
+
+// runHealthCheck probes the connection on opts.HealthCheckInterval while
+// Wait() is blocked, closing the underlying connection if a probe fails so
+// that Wait() unblocks and the normal reconnect path takes over. It returns
+// once stop is closed.
+func (c *reconnect) runHealthCheck(opts Options, stop <-chan struct{}) {
+	if opts.HealthCheck == nil || opts.HealthCheckInterval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(opts.HealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), opts.HealthCheckInterval)
+			err := opts.HealthCheck(ctx)
+			cancel()
+			if err != nil {
+				c.conn.Close()
+				return
+			}
+		}
+	}
+}